@@ -467,9 +467,9 @@ func ESDateHistogram(prefix string, e *State, T miniprofiler.Timer, indexer ESIn
 		if err != nil {
 			return nil, err
 		}
-		ts, found := result.Aggregations.DateHistogram("ts")
-		if !found {
-			return nil, fmt.Errorf("expected time series not found in elastic reply")
+		ts, err := result.Aggregations.DateHistogram("ts")
+		if err != nil {
+			return nil, fmt.Errorf("expected time series not found in elastic reply: %v", err)
 		}
 		series := make(Series)
 		for _, v := range ts.Buckets {
@@ -498,13 +498,13 @@ func ESDateHistogram(prefix string, e *State, T miniprofiler.Timer, indexer ESIn
 	if err != nil {
 		return nil, err
 	}
-	top, ok := result.Aggregations.Terms("g_" + keys[0])
-	if !ok {
-		return nil, fmt.Errorf("top key g_%v not found in result", keys[0])
+	top, err := result.Aggregations.Terms("g_" + keys[0])
+	if err != nil {
+		return nil, fmt.Errorf("top key g_%v not found in result: %v", keys[0], err)
 	}
 	var desc func(*elastic.AggregationBucketKeyItem, opentsdb.TagSet, []string) error
 	desc = func(b *elastic.AggregationBucketKeyItem, tags opentsdb.TagSet, keys []string) error {
-		if ts, found := b.DateHistogram("ts"); found {
+		if ts, err := b.DateHistogram("ts"); err == nil {
 			if e.Squelched(tags) {
 				return nil
 			}
@@ -527,7 +527,10 @@ func ESDateHistogram(prefix string, e *State, T miniprofiler.Timer, indexer ESIn
 		if len(keys) < 1 {
 			return nil
 		}
-		n, _ := b.Aggregations.Terms("g_" + keys[0])
+		n, err := b.Aggregations.Terms("g_" + keys[0])
+		if err != nil {
+			return err
+		}
 		for _, item := range n.Buckets {
 			key := fmt.Sprint(item.Key)
 			tags[keys[0]] = key
@@ -588,7 +591,7 @@ func ScopeES(ts opentsdb.TagSet, q elastic.Query) elastic.Query {
 }
 
 func processESBucketItem(b *elastic.AggregationBucketHistogramItem, rstat string) *float64 {
-	if stats, found := b.ExtendedStats("stats"); found {
+	if stats, err := b.ExtendedStats("stats"); err == nil {
 		var val *float64
 		switch rstat {
 		case "avg":